@@ -0,0 +1,191 @@
+// Package array supports a fleet of LIDAR-Lite v2 units sharing one i2c bus,
+// reassigning each unit's address at startup so they can coexist, and
+// round-robin scanning them with staggered timing for a 2D proximity/SLAM
+// consumer.
+package array
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dasfoo/i2c"
+	lidar "github.com/dasfoo/lidar-lite-v2"
+	"github.com/dasfoo/rpi-gpio"
+)
+
+const (
+	serialNumberLowRegister  = 0x96
+	serialNumberHighRegister = 0x97
+	unlockSerialLowRegister  = 0x18
+	unlockSerialHighRegister = 0x19
+	addressRegister          = 0x1e
+)
+
+// powerOnDelay is how long a unit needs after its enable pin goes high before
+// it responds on the i2c bus.
+const powerOnDelay = 50 * time.Millisecond
+
+// AssignAddresses brings up a fleet of LIDAR-Lite v2 units on one shared i2c
+// bus. It powers each unit up one at a time via enablePins so only it
+// responds at lidar.DefaultAddress, reads its unique serial number from
+// registers 0x96/0x97, writes the matching unlock sequence to registers
+// 0x18/0x19 and assigns it the corresponding entry of addrs via register
+// 0x1e, before moving on to the next unit. enablePins and addrs must be the
+// same length, and every unit should start powered down.
+func AssignAddresses(bus i2c.Bus, enablePins []gpio.Pin, addrs []byte) ([]*lidar.Lidar, error) {
+	if len(enablePins) != len(addrs) {
+		return nil, fmt.Errorf(
+			"array: %d enable pins does not match %d addresses", len(enablePins), len(addrs))
+	}
+
+	for _, pin := range enablePins {
+		pin.SetMode(gpio.OUTPUT)
+		pin.Write(false)
+	}
+
+	units := make([]*lidar.Lidar, len(enablePins))
+	for i, pin := range enablePins {
+		pin.SetMode(gpio.OUTPUT)
+		pin.Write(true)
+		time.Sleep(powerOnDelay)
+
+		ls := lidar.NewLidar(bus, lidar.DefaultAddress)
+		if err := ls.Reset(); err != nil {
+			return nil, fmt.Errorf("array: unit %d failed to reset: %v", i, err)
+		}
+
+		serialLow, err := bus.ReadByteFromReg(lidar.DefaultAddress, serialNumberLowRegister)
+		if err != nil {
+			return nil, fmt.Errorf("array: unit %d failed to read serial number: %v", i, err)
+		}
+		serialHigh, err := bus.ReadByteFromReg(lidar.DefaultAddress, serialNumberHighRegister)
+		if err != nil {
+			return nil, fmt.Errorf("array: unit %d failed to read serial number: %v", i, err)
+		}
+
+		if err := bus.WriteByteToReg(
+			lidar.DefaultAddress, unlockSerialLowRegister, serialLow); err != nil {
+			return nil, fmt.Errorf("array: unit %d failed to unlock address change: %v", i, err)
+		}
+		if err := bus.WriteByteToReg(
+			lidar.DefaultAddress, unlockSerialHighRegister, serialHigh); err != nil {
+			return nil, fmt.Errorf("array: unit %d failed to unlock address change: %v", i, err)
+		}
+		if err := bus.WriteByteToReg(lidar.DefaultAddress, addressRegister, addrs[i]); err != nil {
+			return nil, fmt.Errorf("array: unit %d failed to set new address: %v", i, err)
+		}
+
+		units[i] = lidar.NewLidar(bus, addrs[i])
+	}
+
+	return units, nil
+}
+
+// Reading is one distance/velocity sample from a single unit of an Array,
+// tagged with its index and mounting angle for a 2D proximity/SLAM consumer.
+type Reading struct {
+	Index    int
+	Angle    float64
+	Distance uint16
+	Velocity int16
+}
+
+// Array coordinates a fleet of LIDAR-Lite v2 units sharing one i2c bus, as set
+// up by AssignAddresses.
+type Array struct {
+	Units []*lidar.Lidar
+
+	// Angles holds the mounting angle, in radians, of each entry in Units.
+	// Optional; units beyond len(Angles) are reported with angle 0.
+	Angles []float64
+
+	// StaggerDelay is how long RoundRobinScan waits between triggering
+	// acquisitions on consecutive units, to avoid optical cross-talk between
+	// neighboring units. Defaults to 2ms.
+	StaggerDelay time.Duration
+
+	// StabilizeEvery controls how many reads pass, per unit, between
+	// Acquire(true) calls that stabilize DC, matching the "~ 1 out of every
+	// 100 readings" guidance on (*lidar.Lidar).Acquire. Defaults to 100.
+	StabilizeEvery int
+}
+
+// defaultStabilizeEvery mirrors Sampler's default of the same name.
+const defaultStabilizeEvery = 100
+
+// RoundRobinScan triggers an acquisition on each unit of a in turn, staggered
+// by a.StaggerDelay to avoid optical cross-talk, and streams back a Reading
+// per successful acquisition until ctx is done.
+func (a *Array) RoundRobinScan(ctx context.Context) <-chan Reading {
+	out := make(chan Reading)
+
+	go func() {
+		defer close(out)
+
+		stagger := a.StaggerDelay
+		if stagger <= 0 {
+			stagger = 2 * time.Millisecond
+		}
+		stabilizeEvery := a.StabilizeEvery
+		if stabilizeEvery <= 0 {
+			stabilizeEvery = defaultStabilizeEvery
+		}
+		reads := make([]int, len(a.Units))
+
+		for {
+			for i, unit := range a.Units {
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+
+				stabilize := reads[i]%stabilizeEvery == 0
+				reads[i]++
+
+				reading, ok := a.scanUnit(unit, i, stabilize)
+
+				// Always wait out the stagger before the next unit fires,
+				// including on a failed acquisition, so a unit that errors
+				// out doesn't defeat the optical cross-talk mitigation by
+				// letting its neighbor fire immediately.
+				time.Sleep(stagger)
+
+				if !ok {
+					continue
+				}
+
+				select {
+				case out <- reading:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// scanUnit triggers one acquisition on unit and reads back its distance and
+// velocity, tagging the result with its array index and mounting angle.
+func (a *Array) scanUnit(unit *lidar.Lidar, index int, stabilize bool) (Reading, bool) {
+	if err := unit.Acquire(stabilize); err != nil {
+		return Reading{}, false
+	}
+	distance, err := unit.ReadDistance()
+	if _, healthy := err.(*lidar.HealthError); err != nil && !healthy {
+		return Reading{}, false
+	}
+	velocity, err := unit.ReadVelocity()
+	if _, healthy := err.(*lidar.HealthError); err != nil && !healthy {
+		return Reading{}, false
+	}
+
+	angle := 0.0
+	if index < len(a.Angles) {
+		angle = a.Angles[index]
+	}
+	return Reading{Index: index, Angle: angle, Distance: distance, Velocity: velocity}, true
+}
@@ -0,0 +1,55 @@
+// Command lidar-server opens an i2c bus, constructs a lidar.Lidar and serves
+// it over gRPC as a DistanceSensorService, so another Go process (e.g. a
+// cartographer-style SLAM stack) can consume the sensor remotely instead of
+// opening the i2c bus itself. See the service package doc for why this isn't
+// an interoperable, language-agnostic gRPC service: clients still need to
+// import this module's pb types and service.JSONCodec.
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+
+	"google.golang.org/grpc"
+
+	"github.com/dasfoo/i2c"
+	lidar "github.com/dasfoo/lidar-lite-v2"
+	"github.com/dasfoo/lidar-lite-v2/service"
+	"github.com/dasfoo/lidar-lite-v2/service/pb"
+)
+
+func main() {
+	i2cBus := flag.Int("i2c-bus", 1, "i2c bus number the LIDAR-Lite v2 is attached to")
+	i2cAddr := flag.Uint("i2c-addr", lidar.DefaultAddress, "i2c slave address of the LIDAR-Lite v2")
+	listenAddr := flag.String("listen", ":10205", "address to serve the gRPC DistanceSensorService on")
+	flag.Parse()
+
+	bus, err := i2c.NewBus(byte(*i2cBus))
+	if err != nil {
+		log.Fatalf("failed to open i2c bus %d: %v", *i2cBus, err)
+	}
+	defer bus.Close()
+
+	ls := lidar.NewLidar(bus, byte(*i2cAddr))
+	if err := ls.Reset(); err != nil {
+		log.Fatalf("failed to reset LIDAR: %v", err)
+	}
+
+	listener, err := net.Listen("tcp", *listenAddr)
+	if err != nil {
+		log.Fatalf("failed to listen on %s: %v", *listenAddr, err)
+	}
+
+	// The pb message types aren't real generated protobuf messages (see
+	// service/pb's package doc), so this must run the JSON codec rather than
+	// gRPC's default "proto" codec; clients need to dial with
+	// grpc.ForceCodec(service.JSONCodec{}) to match.
+	grpcServer := grpc.NewServer(grpc.ForceServerCodec(service.JSONCodec{}))
+	pb.RegisterDistanceSensorServiceServer(grpcServer, service.NewServer(ls))
+
+	log.Printf("serving DistanceSensorService on %s", *listenAddr)
+	if err := grpcServer.Serve(listener); err != nil {
+		log.Fatalf("gRPC server stopped: %v", err)
+	}
+}
@@ -0,0 +1,32 @@
+package filter
+
+import "math"
+
+// ewma is an exponentially-weighted moving average filter.
+type ewma struct {
+	alpha       float64
+	initialized bool
+	distance    float64
+	velocity    float64
+}
+
+// EWMA returns a Filter that smooths Distance and Velocity with an
+// exponentially-weighted moving average. alpha is the weight given to each
+// new sample, in (0, 1]; smaller values smooth more but lag more.
+func EWMA(alpha float64) Filter {
+	return &ewma{alpha: alpha}
+}
+
+func (e *ewma) Apply(r Reading) (Reading, bool) {
+	if !e.initialized {
+		e.distance = float64(r.Distance)
+		e.velocity = float64(r.Velocity)
+		e.initialized = true
+	} else {
+		e.distance = e.alpha*float64(r.Distance) + (1-e.alpha)*e.distance
+		e.velocity = e.alpha*float64(r.Velocity) + (1-e.alpha)*e.velocity
+	}
+	r.Distance = uint16(math.Round(e.distance))
+	r.Velocity = int16(math.Round(e.velocity))
+	return r, true
+}
@@ -0,0 +1,41 @@
+// Package filter provides composable smoothing and outlier-rejection filters
+// for LIDAR-Lite v2 distance/velocity samples, so callers don't have to
+// post-process denoising themselves.
+package filter
+
+import "time"
+
+// Reading is a single distance/velocity sample passed through a Filter.
+type Reading struct {
+	Distance  uint16
+	Velocity  int16
+	Timestamp time.Time
+}
+
+// Filter consumes one raw Reading and returns a filtered Reading. Returning
+// ok=false discards the sample, e.g. because Outlier identified it as a
+// spurious spike or dropout. Implementations are stateful and are intended to
+// be called from a single goroutine, matching how Sampler drives them.
+type Filter interface {
+	Apply(Reading) (out Reading, ok bool)
+}
+
+// chain runs a sequence of Filters, feeding each one's output into the next
+// and stopping as soon as one of them discards the reading.
+type chain []Filter
+
+// Chain composes filters in order into a single Filter, e.g.
+// filter.Chain(filter.Outlier(0.5, 2), filter.Median(5)).
+func Chain(filters ...Filter) Filter {
+	return chain(filters)
+}
+
+func (c chain) Apply(r Reading) (Reading, bool) {
+	ok := true
+	for _, f := range c {
+		if r, ok = f.Apply(r); !ok {
+			return Reading{}, false
+		}
+	}
+	return r, true
+}
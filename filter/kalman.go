@@ -0,0 +1,48 @@
+package filter
+
+import "math"
+
+// kalman1D is a scalar (position-only) Kalman filter applied independently to
+// distance and velocity.
+type kalman1D struct {
+	processVar, measVar float64
+
+	initialized bool
+	distanceEst float64
+	distanceCov float64
+	velocityEst float64
+	velocityCov float64
+}
+
+// Kalman1D returns a Filter that smooths Distance and Velocity with a scalar
+// Kalman filter. processVar is the expected variance of the true signal
+// between samples; measVar is the expected variance of sensor noise.
+func Kalman1D(processVar, measVar float64) Filter {
+	return &kalman1D{processVar: processVar, measVar: measVar, distanceCov: 1, velocityCov: 1}
+}
+
+func (k *kalman1D) Apply(r Reading) (Reading, bool) {
+	if !k.initialized {
+		k.distanceEst = float64(r.Distance)
+		k.velocityEst = float64(r.Velocity)
+		k.initialized = true
+		return r, true
+	}
+
+	k.distanceEst, k.distanceCov = k.update(k.distanceEst, k.distanceCov, float64(r.Distance))
+	k.velocityEst, k.velocityCov = k.update(k.velocityEst, k.velocityCov, float64(r.Velocity))
+
+	r.Distance = uint16(math.Round(k.distanceEst))
+	r.Velocity = int16(math.Round(k.velocityEst))
+	return r, true
+}
+
+// update runs one predict+correct step of a scalar Kalman filter and returns
+// the new estimate and error covariance.
+func (k *kalman1D) update(estimate, covariance, measurement float64) (float64, float64) {
+	covariance += k.processVar
+	gain := covariance / (covariance + k.measVar)
+	estimate += gain * (measurement - estimate)
+	covariance *= 1 - gain
+	return estimate, covariance
+}
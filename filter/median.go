@@ -0,0 +1,56 @@
+package filter
+
+import "sort"
+
+// median smooths distance and velocity with a sliding-window median, which is
+// robust to the occasional spike without lagging as much as a mean would.
+type median struct {
+	window     int
+	distances  []uint16
+	velocities []int16
+}
+
+// Median returns a Filter that replaces each Distance/Velocity with the
+// median of the last window samples (including the current one).
+func Median(window int) Filter {
+	if window < 1 {
+		window = 1
+	}
+	return &median{window: window}
+}
+
+func (m *median) Apply(r Reading) (Reading, bool) {
+	m.distances = pushUint16(m.distances, r.Distance, m.window)
+	m.velocities = pushInt16(m.velocities, r.Velocity, m.window)
+	r.Distance = medianUint16(m.distances)
+	r.Velocity = medianInt16(m.velocities)
+	return r, true
+}
+
+func pushUint16(values []uint16, v uint16, window int) []uint16 {
+	values = append(values, v)
+	if len(values) > window {
+		values = values[len(values)-window:]
+	}
+	return values
+}
+
+func pushInt16(values []int16, v int16, window int) []int16 {
+	values = append(values, v)
+	if len(values) > window {
+		values = values[len(values)-window:]
+	}
+	return values
+}
+
+func medianUint16(values []uint16) uint16 {
+	sorted := append([]uint16(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted[len(sorted)/2]
+}
+
+func medianInt16(values []int16) int16 {
+	sorted := append([]int16(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted[len(sorted)/2]
+}
@@ -0,0 +1,43 @@
+package filter
+
+// outlier rejects samples that jump too far from the last accepted distance,
+// which covers both the occasional spurious "0" reading reported by users on
+// shared/noisy setups and genuine spikes. A jump is only rejected for
+// maxDropoutCount consecutive samples; beyond that it's treated as a real
+// change in distance and accepted as the new baseline.
+type outlier struct {
+	maxJumpMeters   float64
+	maxDropoutCount int
+
+	hasBaseline        bool
+	baseline           float64
+	consecutiveRejects int
+}
+
+// Outlier returns a Filter that discards samples more than maxJumpMeters away
+// from the last accepted distance, unless that keeps happening for more than
+// maxDropoutCount consecutive samples.
+func Outlier(maxJumpMeters float64, maxDropoutCount int) Filter {
+	return &outlier{maxJumpMeters: maxJumpMeters, maxDropoutCount: maxDropoutCount}
+}
+
+func (o *outlier) Apply(r Reading) (Reading, bool) {
+	value := float64(r.Distance)
+
+	if !o.hasBaseline {
+		o.hasBaseline = true
+		o.baseline = value
+		return r, true
+	}
+
+	if jump := value - o.baseline; value == 0 || jump > o.maxJumpMeters || -jump > o.maxJumpMeters {
+		o.consecutiveRejects++
+		if o.consecutiveRejects <= o.maxDropoutCount {
+			return Reading{}, false
+		}
+	}
+
+	o.baseline = value
+	o.consecutiveRejects = 0
+	return r, true
+}
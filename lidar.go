@@ -10,6 +10,9 @@ import (
 
 // TODO: add mutex
 
+// ErrTimeout is returned when LIDAR status did not turn non-Busy within WaitTimeout.
+var ErrTimeout = errors.New("Timed out waiting for non-Busy LIDAR status")
+
 // HealthError is returned when LIDAR has unhealthy status
 type HealthError struct {
 	healthFlags byte
@@ -48,11 +51,28 @@ type Lidar struct {
 	bus         i2c.Bus
 	address     byte
 	WaitTimeout time.Duration
+
+	// BusResetFunc, if set, is called by Recover before re-issuing Reset(), to let
+	// callers toggle whatever external line (power-enable GPIO, I2C bus reset, etc.)
+	// is needed to unwedge a LIDAR that stopped responding on a shared bus.
+	BusResetFunc func(i2c.Bus) error
+
+	// MaxConsecutiveErrors is how many consecutive I2C failures waitReadyStatus,
+	// Acquire and ReadDistance tolerate before triggering Recover(). Defaults to 5.
+	MaxConsecutiveErrors int
+
+	consecutiveErrors int
+	recovering        bool
 }
 
 // DefaultAddress is a default i2c slave address of LIDAR-Lite v2
 const DefaultAddress = 0x62
 
+// AlternateAddress is the other commonly used i2c slave address of LIDAR-Lite v2,
+// reachable after a runtime address change (see register 0x1e). Probe() tries it
+// after DefaultAddress.
+const AlternateAddress = 0x66
+
 const (
 	customAcquisitionInterval = 1 << 5
 	velocityModeEnabled       = 1 << 7
@@ -116,6 +136,7 @@ func (ls *Lidar) waitReadyStatus() (status byte, err error) {
 	for {
 		status, err = ls.GetStatus()
 		if err == nil && (status&Busy) == 0 {
+			ls.recordResult(nil)
 			return
 		}
 		if time.Since(startedAt) >= ls.WaitTimeout {
@@ -125,8 +146,9 @@ func (ls *Lidar) waitReadyStatus() (status byte, err error) {
 		backoff *= 2
 	}
 	if err == nil {
-		err = errors.New("Timed out waiting for non-Busy LIDAR status")
+		err = ErrTimeout
 	}
+	ls.recordResult(err)
 	return
 }
 
@@ -269,7 +291,9 @@ func (ls *Lidar) Acquire(stablizePreamp bool) error {
 	if stablizePreamp {
 		command = 0x04
 	}
-	return ls.bus.WriteByteToReg(ls.address, 0x00, command)
+	err := ls.bus.WriteByteToReg(ls.address, 0x00, command)
+	ls.recordResult(err)
+	return err
 }
 
 // ReadDistance waits until acquisition is complete and reads distance. The unit is meters.
@@ -282,6 +306,7 @@ func (ls *Lidar) ReadDistance() (uint16, error) {
 		}
 	}
 	value, err := ls.bus.ReadWordFromReg(ls.address, 0x8f)
+	ls.recordResult(err)
 	if err != nil {
 		return 0, err
 	}
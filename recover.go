@@ -0,0 +1,77 @@
+package lidar
+
+import "fmt"
+
+// candidateAddresses is the set of i2c addresses Probe tries, in order.
+var candidateAddresses = []byte{DefaultAddress, AlternateAddress}
+
+// recordResult feeds the outcome of an i2c operation into the consecutive error
+// counter, triggering Recover once MaxConsecutiveErrors is reached. HealthError
+// is not a communication failure and does not count towards the streak.
+func (ls *Lidar) recordResult(err error) {
+	if err == nil {
+		ls.consecutiveErrors = 0
+		return
+	}
+	if _, ok := err.(*HealthError); ok {
+		ls.consecutiveErrors = 0
+		return
+	}
+	ls.consecutiveErrors++
+	if ls.consecutiveErrors < ls.maxConsecutiveErrors() {
+		return
+	}
+	ls.consecutiveErrors = 0
+
+	// Recover() itself drives the bus (Reset/GetVersion), which feeds back
+	// into recordResult; don't recurse into another recovery attempt while
+	// one is already in progress; let the outer one finish or fail.
+	if ls.recovering {
+		return
+	}
+	ls.recovering = true
+	_ = ls.Recover()
+	ls.recovering = false
+}
+
+func (ls *Lidar) maxConsecutiveErrors() int {
+	if ls.MaxConsecutiveErrors <= 0 {
+		return 5
+	}
+	return ls.MaxConsecutiveErrors
+}
+
+// Recover attempts to bring a wedged LIDAR back to life: it optionally runs
+// BusResetFunc to toggle whatever external reset line the caller wired up,
+// then performs a full Reset() and re-probes with GetVersion to confirm the
+// device answers at its configured address again.
+func (ls *Lidar) Recover() error {
+	if ls.BusResetFunc != nil {
+		if err := ls.BusResetFunc(ls.bus); err != nil {
+			return fmt.Errorf("LIDAR bus reset failed: %v", err)
+		}
+	}
+	if err := ls.Reset(); err != nil {
+		return fmt.Errorf("LIDAR reset during recovery failed: %v", err)
+	}
+	if _, _, err := ls.GetVersion(); err != nil {
+		return fmt.Errorf("LIDAR did not respond at address 0x%x after recovery: %v", ls.address, err)
+	}
+	return nil
+}
+
+// Probe tries each of the known LIDAR-Lite v2 i2c addresses (DefaultAddress and
+// AlternateAddress) until one responds to GetVersion, sets it as the active
+// address and returns it. Useful after a bus reset or address reassignment
+// when the caller no longer knows which address the device is listening on.
+func (ls *Lidar) Probe() (byte, error) {
+	original := ls.address
+	for _, addr := range candidateAddresses {
+		ls.address = addr
+		if _, _, err := ls.GetVersion(); err == nil {
+			return addr, nil
+		}
+	}
+	ls.address = original
+	return 0, fmt.Errorf("no LIDAR responded on candidate addresses %v", candidateAddresses)
+}
@@ -0,0 +1,313 @@
+package lidar
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dasfoo/lidar-lite-v2/filter"
+)
+
+// Sample is a single timestamped distance/velocity reading produced by a Sampler.
+type Sample struct {
+	Distance  uint16
+	Velocity  int16
+	Timestamp time.Time
+}
+
+// Stats holds running counters describing a Sampler's behavior, useful for
+// benchmarking a given acquisition mode/interval combination.
+type Stats struct {
+	Samples      uint64
+	CommErrors   uint64
+	HealthErrors uint64
+	Timeouts     uint64
+	Hz           float64
+}
+
+// sampler state machine, modeled after the collector/measure cycle used by
+// PX4's LidarLiteI2C driver.
+const (
+	stateProbe = iota
+	stateReset
+	stateMeasure
+	stateCollect
+)
+
+// defaultStabilizeEvery is how often Sampler re-issues Acquire(true) to
+// stabilize DC, matching the "~ 1 out of every 100 readings" guidance on
+// (*Lidar).Acquire.
+const defaultStabilizeEvery = 100
+
+// maxConsecutiveErrors is how many consecutive comm/health errors Sampler
+// will tolerate before re-probing and resetting the LIDAR.
+const maxConsecutiveErrors = 5
+
+// Sampler drives a *Lidar from its own goroutine, continuously acquiring
+// distance and velocity and publishing each Sample to a ring buffer and to
+// any number of subscriber channels. It exists so that callers who want a
+// non-blocking stream of readings don't have to hand-write the retry/timeout
+// dance that GetDistance does for a single reading.
+type Sampler struct {
+	lidar *Lidar
+
+	// StabilizeEvery controls how many reads pass between Acquire(true) calls
+	// that stabilize DC. Defaults to defaultStabilizeEvery.
+	StabilizeEvery int
+
+	ring      []Sample
+	ringPos   uint64
+	ringFull  bool
+	ringMutex sync.RWMutex
+
+	subscribers      map[chan Sample]struct{}
+	subscribersMutex sync.Mutex
+
+	stats atomic.Value // Stats
+
+	filter filter.Filter
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// WithFilter installs f to post-process every reading before it is published,
+// e.g. sampler.WithFilter(filter.Chain(filter.Outlier(0.5, 2), filter.Median(5))).
+// It must be called before Start.
+func (s *Sampler) WithFilter(f filter.Filter) *Sampler {
+	s.filter = f
+	return s
+}
+
+// NewSampler creates a Sampler around ls with a ring buffer holding the last
+// ringSize samples (at least 1). The Sampler does not start acquiring until
+// Start is called.
+func NewSampler(ls *Lidar, ringSize int) *Sampler {
+	if ringSize <= 0 {
+		ringSize = 1
+	}
+	s := &Sampler{
+		lidar:          ls,
+		StabilizeEvery: defaultStabilizeEvery,
+		ring:           make([]Sample, ringSize),
+		subscribers:    make(map[chan Sample]struct{}),
+		stop:           make(chan struct{}),
+		done:           make(chan struct{}),
+	}
+	s.stats.Store(Stats{})
+	return s
+}
+
+// Start launches the sampler's acquisition goroutine. It must not be called
+// more than once for a given Sampler.
+func (s *Sampler) Start() {
+	go s.run()
+}
+
+// Stop terminates the acquisition goroutine and closes all subscriber channels.
+func (s *Sampler) Stop() {
+	close(s.stop)
+	<-s.done
+
+	s.subscribersMutex.Lock()
+	for ch := range s.subscribers {
+		delete(s.subscribers, ch)
+		close(ch)
+	}
+	s.subscribersMutex.Unlock()
+}
+
+// Subscribe returns a channel that receives every Sample produced from now on.
+// The channel is buffered; slow readers may miss samples rather than block
+// the sampler. Call Unsubscribe to release it.
+func (s *Sampler) Subscribe() <-chan Sample {
+	ch := make(chan Sample, 16)
+	s.subscribersMutex.Lock()
+	s.subscribers[ch] = struct{}{}
+	s.subscribersMutex.Unlock()
+	return ch
+}
+
+// Unsubscribe stops delivery to a channel previously returned by Subscribe
+// and closes it.
+func (s *Sampler) Unsubscribe(ch <-chan Sample) {
+	s.subscribersMutex.Lock()
+	for c := range s.subscribers {
+		if c == ch {
+			delete(s.subscribers, c)
+			close(c)
+			break
+		}
+	}
+	s.subscribersMutex.Unlock()
+}
+
+// Stats returns a snapshot of the sampler's running counters.
+func (s *Sampler) Stats() Stats {
+	return s.stats.Load().(Stats)
+}
+
+// countError attributes err to the appropriate counter in stats.
+func (s *Sampler) countError(stats *Stats, err error) {
+	if err == ErrTimeout {
+		stats.Timeouts++
+	} else {
+		stats.CommErrors++
+	}
+}
+
+// Latest returns up to n most recent samples, newest last.
+func (s *Sampler) Latest(n int) []Sample {
+	s.ringMutex.RLock()
+	defer s.ringMutex.RUnlock()
+
+	size := len(s.ring)
+	available := s.ringPos
+	if s.ringFull {
+		available = uint64(size)
+	}
+	if uint64(n) > available {
+		n = int(available)
+	}
+	result := make([]Sample, n)
+	for i := 0; i < n; i++ {
+		idx := (int(s.ringPos) - n + i + size) % size
+		result[i] = s.ring[idx]
+	}
+	return result
+}
+
+func (s *Sampler) publish(sample Sample) {
+	s.ringMutex.Lock()
+	size := len(s.ring)
+	s.ring[int(s.ringPos)%size] = sample
+	s.ringPos++
+	if s.ringPos >= uint64(size) {
+		s.ringFull = true
+	}
+	s.ringMutex.Unlock()
+
+	s.subscribersMutex.Lock()
+	for ch := range s.subscribers {
+		select {
+		case ch <- sample:
+		default:
+		}
+	}
+	s.subscribersMutex.Unlock()
+}
+
+func (s *Sampler) run() {
+	defer close(s.done)
+
+	state := stateProbe
+	errorStreak := 0
+	reads := 0
+	windowStart := time.Now()
+	windowSamples := 0
+	stats := s.Stats()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		default:
+		}
+
+		switch state {
+		case stateProbe:
+			if _, _, err := s.lidar.GetVersion(); err != nil {
+				s.countError(&stats, err)
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			state = stateMeasure
+
+		case stateReset:
+			if err := s.lidar.Reset(); err != nil {
+				s.countError(&stats, err)
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			errorStreak = 0
+			state = stateProbe
+
+		case stateMeasure:
+			stabilize := reads%s.stabilizeEvery() == 0
+			if err := s.lidar.Acquire(stabilize); err != nil {
+				s.countError(&stats, err)
+				errorStreak++
+			}
+			reads++
+			state = stateCollect
+
+		case stateCollect:
+			distance, err := s.lidar.ReadDistance()
+			_, distanceHealthy := err.(*HealthError)
+			if err != nil && !distanceHealthy {
+				s.countError(&stats, err)
+				errorStreak++
+			} else if err != nil {
+				stats.HealthErrors++
+				errorStreak++
+			}
+
+			velocity, velErr := s.lidar.ReadVelocity()
+			_, velocityHealthy := velErr.(*HealthError)
+			if velErr != nil && !velocityHealthy {
+				s.countError(&stats, velErr)
+				errorStreak++
+			}
+
+			// A plain comm error (as opposed to a HealthError, where the
+			// reading is still valid) means distance/velocity were not
+			// actually measured; don't count or publish a bogus zero sample.
+			if (err != nil && !distanceHealthy) || (velErr != nil && !velocityHealthy) {
+				s.stats.Store(stats)
+				if errorStreak >= maxConsecutiveErrors {
+					state = stateReset
+				} else {
+					state = stateMeasure
+				}
+				continue
+			}
+
+			if err == nil && velErr == nil {
+				errorStreak = 0
+			}
+			if errorStreak >= maxConsecutiveErrors {
+				state = stateReset
+				continue
+			}
+
+			sample := Sample{Distance: distance, Velocity: velocity, Timestamp: time.Now()}
+			if s.filter != nil {
+				filtered, ok := s.filter.Apply(filter.Reading(sample))
+				if !ok {
+					state = stateMeasure
+					continue
+				}
+				sample = Sample(filtered)
+			}
+
+			stats.Samples++
+			windowSamples++
+			if elapsed := time.Since(windowStart); elapsed >= time.Second {
+				stats.Hz = float64(windowSamples) / elapsed.Seconds()
+				windowSamples = 0
+				windowStart = time.Now()
+			}
+			s.stats.Store(stats)
+
+			s.publish(sample)
+			state = stateMeasure
+		}
+	}
+}
+
+func (s *Sampler) stabilizeEvery() int {
+	if s.StabilizeEvery <= 0 {
+		return defaultStabilizeEvery
+	}
+	return s.StabilizeEvery
+}
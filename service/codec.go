@@ -0,0 +1,23 @@
+package service
+
+import "encoding/json"
+
+// JSONCodec is a grpc/encoding.Codec that marshals messages as JSON instead
+// of protobuf. The pb package's message types are plain structs, not real
+// generated protobuf messages, so the default "proto" codec can't encode
+// them; servers and clients must opt into JSONCodec explicitly with
+// grpc.ForceServerCodec(service.JSONCodec{}) / grpc.ForceCodec(service.JSONCodec{}).
+type JSONCodec struct{}
+
+// Name implements encoding.Codec.
+func (JSONCodec) Name() string { return "json" }
+
+// Marshal implements encoding.Codec.
+func (JSONCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal implements encoding.Codec.
+func (JSONCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
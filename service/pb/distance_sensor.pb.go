@@ -0,0 +1,66 @@
+// Package pb holds the gRPC types for DistanceSensorService, mirroring
+// distance_sensor.proto.
+//
+// These are hand-maintained rather than produced by protoc-gen-go, since this
+// repository doesn't vendor the protobuf/protoc toolchain. The `protobuf`
+// struct tags document the intended wire mapping for whenever this package is
+// regenerated for real (protoc --go_out=. --go-grpc_out=.
+// service/distance_sensor.proto); until then these types are plain structs,
+// not protoreflect.ProtoMessage, and must be served/dialed with the JSON
+// codec defined in the service package (service.JSONCodec), not the
+// default "proto" codec.
+package pb
+
+// AcquisitionMode mirrors the three call sequences documented on lidar.Lidar:
+// SetDistanceOnlyMode, SetContinuousMode and SetDistanceAndVelocityMode.
+type AcquisitionMode int32
+
+const (
+	AcquisitionMode_ACQUISITION_MODE_DISTANCE_ONLY         AcquisitionMode = 0
+	AcquisitionMode_ACQUISITION_MODE_CONTINUOUS            AcquisitionMode = 1
+	AcquisitionMode_ACQUISITION_MODE_DISTANCE_AND_VELOCITY AcquisitionMode = 2
+)
+
+type GetDistanceRequest struct{}
+
+type GetDistanceResponse struct {
+	DistanceMeters uint32 `protobuf:"varint,1,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"`
+}
+
+type GetVelocityRequest struct{}
+
+type GetVelocityResponse struct {
+	VelocityCmPerSec int32 `protobuf:"varint,1,opt,name=velocity_cm_per_sec,json=velocityCmPerSec,proto3" json:"velocity_cm_per_sec,omitempty"`
+}
+
+type StreamDistanceRequest struct {
+	RateHz float32 `protobuf:"fixed32,1,opt,name=rate_hz,json=rateHz,proto3" json:"rate_hz,omitempty"`
+}
+
+type SetModeRequest struct {
+	Mode       AcquisitionMode `protobuf:"varint,1,opt,name=mode,proto3,enum=dasfoo.lidar.v1.AcquisitionMode" json:"mode,omitempty"`
+	IntervalMs uint32          `protobuf:"varint,2,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	Count      uint32          `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+type SetModeResponse struct{}
+
+type ResetRequest struct{}
+
+type ResetResponse struct{}
+
+type GetVersionRequest struct{}
+
+type GetVersionResponse struct {
+	HardwareVersion uint32 `protobuf:"varint,1,opt,name=hardware_version,json=hardwareVersion,proto3" json:"hardware_version,omitempty"`
+	SoftwareVersion uint32 `protobuf:"varint,2,opt,name=software_version,json=softwareVersion,proto3" json:"software_version,omitempty"`
+}
+
+type ReadingsRequest struct{}
+
+type ReadingsResponse struct {
+	DistanceMeters   uint32 `protobuf:"varint,1,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"`
+	VelocityCmPerSec int32  `protobuf:"varint,2,opt,name=velocity_cm_per_sec,json=velocityCmPerSec,proto3" json:"velocity_cm_per_sec,omitempty"`
+	HealthFlags      uint32 `protobuf:"varint,3,opt,name=health_flags,json=healthFlags,proto3" json:"health_flags,omitempty"`
+	Healthy          bool   `protobuf:"varint,4,opt,name=healthy,proto3" json:"healthy,omitempty"`
+}
@@ -0,0 +1,309 @@
+// Package pb: hand-maintained gRPC service wiring for distance_sensor.proto.
+//
+// This file is normally produced by protoc-gen-go-grpc, but this repository
+// does not vendor the protobuf/protoc toolchain, so it is maintained by hand
+// instead; keep it in sync with distance_sensor.proto and regenerate with the
+// real toolchain (protoc --go-grpc_out=.) once it's available, replacing this
+// file wholesale. Because the message types in distance_sensor.pb.go are
+// plain structs rather than real generated protobuf messages (they don't
+// implement proto.Message/protoreflect), this service must be served and
+// dialed with the JSON codec defined in the service package
+// (service.JSONCodec), not the default "proto" codec.
+package pb
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// DistanceSensorServiceClient is the client API for DistanceSensorService.
+type DistanceSensorServiceClient interface {
+	GetDistance(ctx context.Context, in *GetDistanceRequest, opts ...grpc.CallOption) (*GetDistanceResponse, error)
+	GetVelocity(ctx context.Context, in *GetVelocityRequest, opts ...grpc.CallOption) (*GetVelocityResponse, error)
+	StreamDistance(ctx context.Context, in *StreamDistanceRequest, opts ...grpc.CallOption) (DistanceSensorService_StreamDistanceClient, error)
+	SetMode(ctx context.Context, in *SetModeRequest, opts ...grpc.CallOption) (*SetModeResponse, error)
+	Reset(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*ResetResponse, error)
+	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
+	Readings(ctx context.Context, in *ReadingsRequest, opts ...grpc.CallOption) (*ReadingsResponse, error)
+}
+
+type distanceSensorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewDistanceSensorServiceClient wraps cc as a DistanceSensorServiceClient.
+// cc must have been dialed with service.JSONCodec as its codec.
+func NewDistanceSensorServiceClient(cc grpc.ClientConnInterface) DistanceSensorServiceClient {
+	return &distanceSensorServiceClient{cc: cc}
+}
+
+func (c *distanceSensorServiceClient) GetDistance(
+	ctx context.Context, in *GetDistanceRequest, opts ...grpc.CallOption,
+) (*GetDistanceResponse, error) {
+	out := new(GetDistanceResponse)
+	if err := c.cc.Invoke(ctx, distanceSensorServiceMethodPrefix+"GetDistance", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *distanceSensorServiceClient) GetVelocity(
+	ctx context.Context, in *GetVelocityRequest, opts ...grpc.CallOption,
+) (*GetVelocityResponse, error) {
+	out := new(GetVelocityResponse)
+	if err := c.cc.Invoke(ctx, distanceSensorServiceMethodPrefix+"GetVelocity", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *distanceSensorServiceClient) StreamDistance(
+	ctx context.Context, in *StreamDistanceRequest, opts ...grpc.CallOption,
+) (DistanceSensorService_StreamDistanceClient, error) {
+	stream, err := c.cc.NewStream(
+		ctx, &_DistanceSensorService_serviceDesc.Streams[0],
+		distanceSensorServiceMethodPrefix+"StreamDistance", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &distanceSensorServiceStreamDistanceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type distanceSensorServiceStreamDistanceClient struct {
+	grpc.ClientStream
+}
+
+func (x *distanceSensorServiceStreamDistanceClient) Recv() (*GetDistanceResponse, error) {
+	m := new(GetDistanceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *distanceSensorServiceClient) SetMode(
+	ctx context.Context, in *SetModeRequest, opts ...grpc.CallOption,
+) (*SetModeResponse, error) {
+	out := new(SetModeResponse)
+	if err := c.cc.Invoke(ctx, distanceSensorServiceMethodPrefix+"SetMode", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *distanceSensorServiceClient) Reset(
+	ctx context.Context, in *ResetRequest, opts ...grpc.CallOption,
+) (*ResetResponse, error) {
+	out := new(ResetResponse)
+	if err := c.cc.Invoke(ctx, distanceSensorServiceMethodPrefix+"Reset", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *distanceSensorServiceClient) GetVersion(
+	ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption,
+) (*GetVersionResponse, error) {
+	out := new(GetVersionResponse)
+	if err := c.cc.Invoke(ctx, distanceSensorServiceMethodPrefix+"GetVersion", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *distanceSensorServiceClient) Readings(
+	ctx context.Context, in *ReadingsRequest, opts ...grpc.CallOption,
+) (*ReadingsResponse, error) {
+	out := new(ReadingsResponse)
+	if err := c.cc.Invoke(ctx, distanceSensorServiceMethodPrefix+"Readings", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DistanceSensorService_StreamDistanceClient is returned by StreamDistance.
+type DistanceSensorService_StreamDistanceClient interface {
+	Recv() (*GetDistanceResponse, error)
+	grpc.ClientStream
+}
+
+// DistanceSensorServiceServer is the server API for DistanceSensorService.
+// All implementations must embed UnimplementedDistanceSensorServiceServer
+// for forward compatibility.
+type DistanceSensorServiceServer interface {
+	GetDistance(context.Context, *GetDistanceRequest) (*GetDistanceResponse, error)
+	GetVelocity(context.Context, *GetVelocityRequest) (*GetVelocityResponse, error)
+	StreamDistance(*StreamDistanceRequest, DistanceSensorService_StreamDistanceServer) error
+	SetMode(context.Context, *SetModeRequest) (*SetModeResponse, error)
+	Reset(context.Context, *ResetRequest) (*ResetResponse, error)
+	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
+	Readings(context.Context, *ReadingsRequest) (*ReadingsResponse, error)
+	mustEmbedUnimplementedDistanceSensorServiceServer()
+}
+
+// DistanceSensorService_StreamDistanceServer is used by StreamDistance implementations.
+type DistanceSensorService_StreamDistanceServer interface {
+	Send(*GetDistanceResponse) error
+	grpc.ServerStream
+}
+
+type distanceSensorServiceStreamDistanceServer struct {
+	grpc.ServerStream
+}
+
+func (x *distanceSensorServiceStreamDistanceServer) Send(m *GetDistanceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// UnimplementedDistanceSensorServiceServer must be embedded by every
+// implementation so new RPCs don't break the build.
+type UnimplementedDistanceSensorServiceServer struct{}
+
+func (UnimplementedDistanceSensorServiceServer) mustEmbedUnimplementedDistanceSensorServiceServer() {}
+
+// RegisterDistanceSensorServiceServer registers srv with s.
+func RegisterDistanceSensorServiceServer(s grpc.ServiceRegistrar, srv DistanceSensorServiceServer) {
+	s.RegisterService(&_DistanceSensorService_serviceDesc, srv)
+}
+
+const distanceSensorServiceMethodPrefix = "/dasfoo.lidar.v1.DistanceSensorService/"
+
+func _DistanceSensorService_GetDistance_Handler(
+	srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(GetDistanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DistanceSensorServiceServer).GetDistance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: distanceSensorServiceMethodPrefix + "GetDistance"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DistanceSensorServiceServer).GetDistance(ctx, req.(*GetDistanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DistanceSensorService_GetVelocity_Handler(
+	srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(GetVelocityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DistanceSensorServiceServer).GetVelocity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: distanceSensorServiceMethodPrefix + "GetVelocity"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DistanceSensorServiceServer).GetVelocity(ctx, req.(*GetVelocityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DistanceSensorService_SetMode_Handler(
+	srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(SetModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DistanceSensorServiceServer).SetMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: distanceSensorServiceMethodPrefix + "SetMode"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DistanceSensorServiceServer).SetMode(ctx, req.(*SetModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DistanceSensorService_Reset_Handler(
+	srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(ResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DistanceSensorServiceServer).Reset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: distanceSensorServiceMethodPrefix + "Reset"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DistanceSensorServiceServer).Reset(ctx, req.(*ResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DistanceSensorService_GetVersion_Handler(
+	srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(GetVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DistanceSensorServiceServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: distanceSensorServiceMethodPrefix + "GetVersion"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DistanceSensorServiceServer).GetVersion(ctx, req.(*GetVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DistanceSensorService_Readings_Handler(
+	srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(ReadingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DistanceSensorServiceServer).Readings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: distanceSensorServiceMethodPrefix + "Readings"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DistanceSensorServiceServer).Readings(ctx, req.(*ReadingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DistanceSensorService_StreamDistance_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamDistanceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DistanceSensorServiceServer).StreamDistance(
+		m, &distanceSensorServiceStreamDistanceServer{stream})
+}
+
+var _DistanceSensorService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dasfoo.lidar.v1.DistanceSensorService",
+	HandlerType: (*DistanceSensorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "GetDistance", Handler: _DistanceSensorService_GetDistance_Handler},
+		{MethodName: "GetVelocity", Handler: _DistanceSensorService_GetVelocity_Handler},
+		{MethodName: "SetMode", Handler: _DistanceSensorService_SetMode_Handler},
+		{MethodName: "Reset", Handler: _DistanceSensorService_Reset_Handler},
+		{MethodName: "GetVersion", Handler: _DistanceSensorService_GetVersion_Handler},
+		{MethodName: "Readings", Handler: _DistanceSensorService_Readings_Handler},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamDistance",
+			Handler:       _DistanceSensorService_StreamDistance_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "distance_sensor.proto",
+}
@@ -0,0 +1,157 @@
+// Package service exposes a *lidar.Lidar over gRPC as a generic distance
+// sensor, modeled after Viam's SLAM/sensor module RPC shape, so a separate Go
+// process (e.g. a cartographer-style SLAM stack written in Go) can consume
+// the sensor remotely instead of opening the i2c bus itself.
+//
+// This is not a standards-compliant, language-agnostic gRPC service: the
+// message types in the pb subpackage are plain structs, not real generated
+// protobuf messages, so the wire format is JSON rather than protobuf (see
+// JSONCodec). Any client, Go or otherwise, must dial with
+// grpc.ForceCodec(service.JSONCodec{}) and use the pb request/response types
+// from this module; tools expecting a standard protobuf gRPC service
+// (grpcurl, non-Go clients, reflection) will not work against it.
+package service
+
+import (
+	"context"
+	"time"
+
+	lidar "github.com/dasfoo/lidar-lite-v2"
+	"github.com/dasfoo/lidar-lite-v2/service/pb"
+)
+
+// minStreamDistanceInterval bounds how fast StreamDistance will tick,
+// regardless of the requested rate_hz, so a very large (or precision-lossy)
+// client-supplied rate can't compute a zero or negative ticker interval.
+const minStreamDistanceInterval = time.Millisecond
+
+// Server implements pb.DistanceSensorServiceServer on top of a *lidar.Lidar.
+type Server struct {
+	pb.UnimplementedDistanceSensorServiceServer
+
+	Lidar *lidar.Lidar
+}
+
+// NewServer wraps ls so it can be registered on a *grpc.Server via
+// pb.RegisterDistanceSensorServiceServer.
+func NewServer(ls *lidar.Lidar) *Server {
+	return &Server{Lidar: ls}
+}
+
+// GetDistance returns a single distance measurement, as GetDistance does.
+func (s *Server) GetDistance(ctx context.Context, req *pb.GetDistanceRequest) (*pb.GetDistanceResponse, error) {
+	value, err := s.Lidar.GetDistance()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetDistanceResponse{DistanceMeters: uint32(value)}, nil
+}
+
+// GetVelocity acquires and returns a single velocity measurement.
+func (s *Server) GetVelocity(ctx context.Context, req *pb.GetVelocityRequest) (*pb.GetVelocityResponse, error) {
+	if err := s.Lidar.Acquire(true); err != nil {
+		return nil, err
+	}
+	value, err := s.Lidar.ReadVelocity()
+	if _, ok := err.(*lidar.HealthError); err != nil && !ok {
+		return nil, err
+	}
+	return &pb.GetVelocityResponse{VelocityCmPerSec: int32(value)}, nil
+}
+
+// StreamDistance streams distance measurements at approximately req.RateHz
+// until the client cancels the RPC.
+func (s *Server) StreamDistance(
+	req *pb.StreamDistanceRequest, stream pb.DistanceSensorService_StreamDistanceServer,
+) error {
+	rate := req.RateHz
+	if rate <= 0 {
+		rate = 10
+	}
+	interval := time.Duration(float64(time.Second) / float64(rate))
+	if interval < minStreamDistanceInterval {
+		interval = minStreamDistanceInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-ticker.C:
+			value, err := s.Lidar.GetDistance()
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(&pb.GetDistanceResponse{DistanceMeters: uint32(value)}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SetMode configures the acquisition mode and its interval/count parameters.
+func (s *Server) SetMode(ctx context.Context, req *pb.SetModeRequest) (*pb.SetModeResponse, error) {
+	interval := time.Duration(req.IntervalMs) * time.Millisecond
+	if interval == 0 {
+		interval = lidar.DefaultAcquisitionInterval
+	}
+
+	var err error
+	switch req.Mode {
+	case pb.AcquisitionMode_ACQUISITION_MODE_DISTANCE_ONLY:
+		err = s.Lidar.SetDistanceOnlyMode()
+	case pb.AcquisitionMode_ACQUISITION_MODE_CONTINUOUS:
+		err = s.Lidar.SetContinuousMode(byte(req.Count), interval)
+	case pb.AcquisitionMode_ACQUISITION_MODE_DISTANCE_AND_VELOCITY:
+		err = s.Lidar.SetDistanceAndVelocityMode(interval)
+	default:
+		err = s.Lidar.SetDistanceOnlyMode()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetModeResponse{}, nil
+}
+
+// Reset re-loads the LIDAR's FPGA and resets all registers to defaults.
+func (s *Server) Reset(ctx context.Context, req *pb.ResetRequest) (*pb.ResetResponse, error) {
+	if err := s.Lidar.Reset(); err != nil {
+		return nil, err
+	}
+	return &pb.ResetResponse{}, nil
+}
+
+// GetVersion returns hardware and software revision of the LIDAR.
+func (s *Server) GetVersion(ctx context.Context, req *pb.GetVersionRequest) (*pb.GetVersionResponse, error) {
+	hw, sw, err := s.Lidar.GetVersion()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetVersionResponse{HardwareVersion: uint32(hw), SoftwareVersion: uint32(sw)}, nil
+}
+
+// Readings returns the latest distance, velocity and health flags in one call.
+func (s *Server) Readings(ctx context.Context, req *pb.ReadingsRequest) (*pb.ReadingsResponse, error) {
+	if err := s.Lidar.Acquire(true); err != nil {
+		return nil, err
+	}
+	distance, distErr := s.Lidar.ReadDistance()
+	velocity, velErr := s.Lidar.ReadVelocity()
+
+	resp := &pb.ReadingsResponse{
+		DistanceMeters:   uint32(distance),
+		VelocityCmPerSec: int32(velocity),
+		Healthy:          true,
+	}
+	for _, err := range []error{distErr, velErr} {
+		if healthErr, ok := err.(*lidar.HealthError); ok {
+			resp.Healthy = false
+			resp.HealthFlags = uint32(healthErr.GetHealthFlags())
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,83 @@
+package lidar
+
+import "fmt"
+
+const (
+	maxAcquisitionCountRegister  = 0x02
+	correlationThresholdRegister = 0x1c
+	fastModeEnabled              = 1 << 6
+)
+
+// SetMaxAcquisitionCount sets the maximum number of times LIDAR will let the
+// reference and signal acquisitions run before giving up (register 0x02).
+// Lower values trade measurement quality for speed; see Preset for common
+// combinations.
+func (ls *Lidar) SetMaxAcquisitionCount(n byte) error {
+	return ls.bus.WriteByteToReg(ls.address, maxAcquisitionCountRegister, n)
+}
+
+// SetCorrelationThreshold sets the correlation record noise-floor threshold
+// below which a signal peak is not considered a detection. 0 tells LIDAR to
+// pick the threshold automatically.
+func (ls *Lidar) SetCorrelationThreshold(t byte) error {
+	return ls.bus.WriteByteToReg(ls.address, correlationThresholdRegister, t)
+}
+
+// SetFastMode toggles the free-running "fast" acquisition mode (bit 6 of the
+// mode control register), which trades accuracy and range for measurement rate.
+func (ls *Lidar) SetFastMode(enable bool) error {
+	control, err := ls.bus.ReadByteFromReg(ls.address, modeControlRegister)
+	if err != nil {
+		return err
+	}
+	if enable {
+		control |= fastModeEnabled
+	} else {
+		control &^= fastModeEnabled
+	}
+	return ls.bus.WriteByteToReg(ls.address, modeControlRegister, control)
+}
+
+// Preset is a named combination of acquisition-count, correlation-threshold
+// and fast-mode settings trading accuracy/range for measurement rate.
+type Preset int
+
+// Presets, roughly ordered from most accurate/longest range to fastest.
+const (
+	PresetDefault Preset = iota
+	PresetHighAccuracy
+	PresetMaxRange
+	PresetShortRange
+	PresetHighSpeed
+)
+
+type presetConfig struct {
+	maxAcquisitionCount  byte
+	correlationThreshold byte
+	fastMode             bool
+}
+
+var presetConfigs = map[Preset]presetConfig{
+	PresetDefault:      {maxAcquisitionCount: 0x80, correlationThreshold: 0x00, fastMode: false},
+	PresetHighAccuracy: {maxAcquisitionCount: 0xff, correlationThreshold: 0x00, fastMode: false},
+	PresetMaxRange:     {maxAcquisitionCount: 0xff, correlationThreshold: 0x20, fastMode: false},
+	PresetShortRange:   {maxAcquisitionCount: 0x40, correlationThreshold: 0x40, fastMode: true},
+	PresetHighSpeed:    {maxAcquisitionCount: 0x20, correlationThreshold: 0x60, fastMode: true},
+}
+
+// ApplyPreset writes the register combination for p, trading measurement
+// quality for rate (roughly ~250 Hz for PresetDefault up to ~1050 Hz for
+// PresetHighSpeed), so callers don't need to know the individual registers.
+func (ls *Lidar) ApplyPreset(p Preset) error {
+	cfg, ok := presetConfigs[p]
+	if !ok {
+		return fmt.Errorf("unknown LIDAR preset %d", p)
+	}
+	if err := ls.SetMaxAcquisitionCount(cfg.maxAcquisitionCount); err != nil {
+		return err
+	}
+	if err := ls.SetCorrelationThreshold(cfg.correlationThreshold); err != nil {
+		return err
+	}
+	return ls.SetFastMode(cfg.fastMode)
+}